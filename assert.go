@@ -5,16 +5,24 @@
 package assert
 
 import (
-	"errors"
-	"reflect"
-	"strings"
 	"testing"
+
+	"github.com/renormlabs/assert/internal/diff"
+	"github.com/renormlabs/assert/internal/predicate"
 )
 
+// [SetDiffRenderer] overrides the renderer used to describe [DeepEqual]/[NotDeepEqual] failures
+// (including the [match.DeepEqualTo] matcher and the require package's equivalents), letting
+// callers plug in e.g. a go-spew or go-cmp-style renderer. Passing nil restores the built-in,
+// dependency-free renderer.
+func SetDiffRenderer(renderer func(expected, actual any) string) {
+	diff.SetRenderer(renderer)
+}
+
 // [Equalf] asserts that two values are equal. If they are not, it reports an error with the given formatting.
 func Equalf[T comparable](t testing.TB, expected, actual T, format string, args ...any) {
 	t.Helper()
-	if expected != actual {
+	if !predicate.Equal(expected, actual) {
 		t.Errorf(format, args...)
 	}
 }
@@ -28,7 +36,7 @@ func Equal[T comparable](t testing.TB, expected, actual T) {
 // [NotEqualf] asserts that two values are not equal. If they are, it reports an error with the given formatting.
 func NotEqualf[T comparable](t testing.TB, expected, actual T, format string, args ...any) {
 	t.Helper()
-	if expected == actual {
+	if !predicate.NotEqual(expected, actual) {
 		t.Errorf(format, args...)
 	}
 }
@@ -66,7 +74,7 @@ func False(t testing.TB, actual bool) {
 // [Nilf] asserts that a value is nil. If it is not, it reports an error with the given formatting.
 func Nilf(t testing.TB, actual any, format string, args ...any) {
 	t.Helper()
-	if actual != nil {
+	if !predicate.Nil(actual) {
 		t.Errorf(format, args...)
 	}
 }
@@ -80,7 +88,7 @@ func Nil(t testing.TB, actual any) {
 // [NotNilf] asserts that a value is not nil. If it is, it reports an error with the given formatting.
 func NotNilf(t testing.TB, actual any, format string, args ...any) {
 	t.Helper()
-	if actual == nil {
+	if !predicate.NotNil(actual) {
 		t.Errorf(format, args...)
 	}
 }
@@ -94,7 +102,7 @@ func NotNil(t testing.TB, actual any) {
 // [StringContainsf] asserts that a string contains a substring. If it does not, it reports an error with the given formatting.
 func StringContainsf(t testing.TB, str, substr string, format string, args ...any) {
 	t.Helper()
-	if !strings.Contains(str, substr) {
+	if !predicate.StringContains(str, substr) {
 		t.Errorf(format, args...)
 	}
 }
@@ -108,7 +116,7 @@ func StringContains(t testing.TB, str, substr string) {
 // [StringNotContainsf] asserts that a string does not contain a substring. If it does, it reports an error with the given formatting.
 func StringDoesNotContainf(t testing.TB, str, substr string, format string, args ...any) {
 	t.Helper()
-	if strings.Contains(str, substr) {
+	if !predicate.StringDoesNotContain(str, substr) {
 		t.Errorf(format, args...)
 	}
 }
@@ -122,14 +130,11 @@ func StringDoesNotContain(t testing.TB, str, substr string) {
 // [Panicsf] asserts that a function panics. If it does not, it reports an error with the given formatting.
 func Panicsf(t testing.TB, f func(), format string, args ...any) (recovery any) {
 	t.Helper()
-	defer func() {
-		recovery = recover()
-		if recovery == nil {
-			t.Errorf(format, args...)
-		}
-	}()
-	f()
-	return
+	panicked, recovered := predicate.Panics(f)
+	if !panicked {
+		t.Errorf(format, args...)
+	}
+	return recovered
 }
 
 // [Panics] asserts that a function panics using [Panicsf] with a default message.
@@ -141,12 +146,9 @@ func Panics(t testing.TB, f func()) any {
 // [DoesNotPanicf] asserts that a function does not panic. If it does, it reports an error with the given formatting.
 func DoesNotPanicf(t testing.TB, f func(), format string, args ...any) {
 	t.Helper()
-	defer func() {
-		if r := recover(); r != nil {
-			t.Errorf(format, args...)
-		}
-	}()
-	f()
+	if !predicate.DoesNotPanic(f) {
+		t.Errorf(format, args...)
+	}
 }
 
 // [DoesNotPanic] asserts that a function does not panic using [DoesNotPanicf] with a default message.
@@ -158,7 +160,7 @@ func DoesNotPanic(t testing.TB, f func()) {
 // [ErrorIsf] asserts that an error is of a specific type. If it is not, it reports an error with the given formatting.
 func ErrorIsf(t testing.TB, err, target error, format string, args ...any) {
 	t.Helper()
-	if !errors.Is(err, target) {
+	if !predicate.ErrorIs(err, target) {
 		t.Errorf(format, args...)
 	}
 }
@@ -172,7 +174,7 @@ func ErrorIs(t testing.TB, err, target error) {
 // [ErrorIsNotf] asserts that an error is not of a specific type. If it is, it reports an error with the given formatting.
 func ErrorIsNotf(t testing.TB, err, target error, format string, args ...any) {
 	t.Helper()
-	if errors.Is(err, target) {
+	if !predicate.ErrorIsNot(err, target) {
 		t.Errorf(format, args...)
 	}
 }
@@ -186,7 +188,7 @@ func ErrorIsNot(t testing.TB, err, target error) {
 // [MapContainsKeyf] asserts that a map contains a specific key. If it does not, it reports an error with the given formatting.
 func MapContainsKeyf[K comparable, V any](t testing.TB, m map[K]V, key K, format string, args ...any) {
 	t.Helper()
-	if _, ok := m[key]; !ok {
+	if !predicate.MapContainsKey(m, key) {
 		t.Errorf(format, args...)
 	}
 }
@@ -200,7 +202,7 @@ func MapContainsKey[K comparable, V any](t testing.TB, m map[K]V, key K) {
 // [MapDoesNotContainKeyf] asserts that a map does not contain a specific key. If it does, it reports an error with the given formatting.
 func MapDoesNotContainKeyf[K comparable, V any](t testing.TB, m map[K]V, key K, format string, args ...any) {
 	t.Helper()
-	if _, ok := m[key]; ok {
+	if !predicate.MapDoesNotContainKey(m, key) {
 		t.Errorf(format, args...)
 	}
 }
@@ -214,7 +216,7 @@ func MapDoesNotContainKey[K comparable, V any](t testing.TB, m map[K]V, key K) {
 // [EmptyMapf] asserts that a map is empty. If it is not, it reports an error with the given formatting.
 func EmptyMapf[K comparable, V any](t testing.TB, m map[K]V, format string, args ...any) {
 	t.Helper()
-	if len(m) != 0 {
+	if !predicate.EmptyMap(m) {
 		t.Errorf(format, args...)
 	}
 }
@@ -228,7 +230,7 @@ func EmptyMap[K comparable, V any](t testing.TB, m map[K]V) {
 // [NotEmptyMapf] asserts that a map is not empty. If it is, it reports an error with the given formatting.
 func NotEmptyMapf[K comparable, V any](t testing.TB, m map[K]V, format string, args ...any) {
 	t.Helper()
-	if len(m) == 0 {
+	if !predicate.NotEmptyMap(m) {
 		t.Errorf(format, args...)
 	}
 }
@@ -242,7 +244,7 @@ func NotEmptyMap[K comparable, V any](t testing.TB, m map[K]V) {
 // [EmptySlicef] asserts that a slice is empty. If it is not, it reports an error with the given formatting.
 func EmptySlicef[T any](t testing.TB, slice []T, format string, args ...any) {
 	t.Helper()
-	if len(slice) != 0 {
+	if !predicate.EmptySlice(slice) {
 		t.Errorf(format, args...)
 	}
 }
@@ -256,7 +258,7 @@ func EmptySlice[T any](t testing.TB, slice []T) {
 // [NotEmptySlicef] asserts that a slice is not empty. If it is, it reports an error with the given formatting.
 func NotEmptySlicef[T any](t testing.TB, slice []T, format string, args ...any) {
 	t.Helper()
-	if len(slice) == 0 {
+	if !predicate.NotEmptySlice(slice) {
 		t.Errorf(format, args...)
 	}
 }
@@ -270,27 +272,30 @@ func NotEmptySlice[T any](t testing.TB, slice []T) {
 // [DeepEqualf] asserts that two values are equal per [reflect.DeepEqual]. If they are not, it reports an error with the given formatting.
 func DeepEqualf[T any](t testing.TB, expected, actual T, format string, args ...any) {
 	t.Helper()
-	if !reflect.DeepEqual(expected, actual) {
+	if !predicate.DeepEqual(expected, actual) {
 		t.Errorf(format, args...)
 	}
 }
 
-// [DeepEqual] asserts that two values are equal per [reflect.DeepEqual] using [DeepEqualf] with a default message.
+// [DeepEqual] asserts that two values are equal per [reflect.DeepEqual] using [DeepEqualf] with a
+// default message that includes a structured diff of expected and actual; see [SetDiffRenderer].
 func DeepEqual[T any](t testing.TB, expected, actual T) {
 	t.Helper()
-	DeepEqualf(t, expected, actual, "expected %v to reflect.DeepEqual %v", actual, expected)
+	DeepEqualf(t, expected, actual, "expected values to be equal:\n%s", diff.Describe(expected, actual))
 }
 
 // [NotDeepEqualf] asserts that two values are not equal per [reflect.DeepEqual]. If they are, it reports an error with the given formatting.
 func NotDeepEqualf[T any](t testing.TB, expected, actual T, format string, args ...any) {
 	t.Helper()
-	if reflect.DeepEqual(expected, actual) {
+	if !predicate.NotDeepEqual(expected, actual) {
 		t.Errorf(format, args...)
 	}
 }
 
-// [NotDeepEqual] asserts that two values are not equal per [reflect.DeepEqual] using [NotDeepEqualf] with a default message.
+// [NotDeepEqual] asserts that two values are not equal per [reflect.DeepEqual] using
+// [NotDeepEqualf] with a default message that includes a structured diff of expected and actual;
+// see [SetDiffRenderer].
 func NotDeepEqual[T any](t testing.TB, expected, actual T) {
 	t.Helper()
-	NotDeepEqualf(t, expected, actual, "expected %v to not reflect.DeepEqual %v", actual, expected)
+	NotDeepEqualf(t, expected, actual, "expected values to not be equal:\n%s", diff.Describe(expected, actual))
 }
@@ -0,0 +1,14 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+// Package by provides helpers that resolve a value to assert on, for use inside fluent retry
+// helpers like assert.WithinSeconds.
+package by
+
+// [Channelling] returns the next value received from ch, blocking until one is available or ch is
+// closed (in which case it returns the zero value for T). It is meant to be called on every
+// attempt of a retrying assertion, e.g. assert.WithinSeconds, so each attempt observes the next
+// value produced on the channel.
+func Channelling[T any](ch <-chan T) T {
+	v := <-ch
+	return v
+}
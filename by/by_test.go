@@ -0,0 +1,30 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package by_test
+
+import (
+	"testing"
+
+	"github.com/renormlabs/assert"
+	"github.com/renormlabs/assert/by"
+)
+
+func TestChannelling(t *testing.T) {
+	ch := make(chan string, 1)
+	ch <- "hello"
+	assert.Equal(t, "hello", by.Channelling(ch))
+}
+
+func TestChannellingBlocksUntilAValueArrives(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		ch <- 7
+	}()
+	assert.Equal(t, 7, by.Channelling(ch))
+}
+
+func TestChannellingReturnsZeroValueOnClosedChannel(t *testing.T) {
+	ch := make(chan int)
+	close(ch)
+	assert.Equal(t, 0, by.Channelling(ch))
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package assert
+
+import (
+	"testing"
+
+	"github.com/renormlabs/assert/match"
+)
+
+// [Chain] accumulates assertions against a single subject so they can be expressed as a pipeline
+// without repeating the subject at every step. Obtain one with [That].
+type Chain[T any] struct {
+	t       testing.TB
+	subject T
+}
+
+// [That] starts a fluent chain of assertions against subject. Each method on the returned [Chain]
+// reports through t and returns the chain so calls can be combined, e.g.
+// assert.That(t, value).IsEqualTo(x).IsNotNil(). Any matchers passed are applied immediately, as
+// with [Chain.Satisfies], so a single call like
+// assert.That(t, subject, match.AllOf(match.StringContaining("abc"), match.Not(match.EqualTo("abcdef"))))
+// both evaluates the matchers and returns a chain for further assertions.
+func That[T any](t testing.TB, subject T, matchers ...match.Matcher[T]) *Chain[T] {
+	t.Helper()
+	c := &Chain[T]{t: t, subject: subject}
+	for _, m := range matchers {
+		c.Satisfies(m)
+	}
+	return c
+}
+
+// [Satisfies] asserts that the subject satisfies m, reporting m's own failure description.
+func (c *Chain[T]) Satisfies(m match.Matcher[T]) *Chain[T] {
+	c.t.Helper()
+	if ok, msg := m.Match(c.subject); !ok {
+		c.t.Errorf("%s", msg)
+	}
+	return c
+}
+
+// [IsEqualTo] asserts that the subject is equal to expected per [reflect.DeepEqual].
+func (c *Chain[T]) IsEqualTo(expected T) *Chain[T] {
+	c.t.Helper()
+	DeepEqualf(c.t, expected, c.subject, "expected %v to equal %v", c.subject, expected)
+	return c
+}
+
+// [IsNotEqualTo] asserts that the subject is not equal to expected per [reflect.DeepEqual].
+func (c *Chain[T]) IsNotEqualTo(expected T) *Chain[T] {
+	c.t.Helper()
+	NotDeepEqualf(c.t, expected, c.subject, "expected %v to not equal %v", c.subject, expected)
+	return c
+}
+
+// [IsNil] asserts that the subject is nil.
+func (c *Chain[T]) IsNil() *Chain[T] {
+	c.t.Helper()
+	Nilf(c.t, c.subject, "expected %v to be nil", c.subject)
+	return c
+}
+
+// [IsNotNil] asserts that the subject is not nil.
+func (c *Chain[T]) IsNotNil() *Chain[T] {
+	c.t.Helper()
+	NotNilf(c.t, c.subject, "expected %v to not be nil", c.subject)
+	return c
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/renormlabs/assert"
+	"github.com/renormlabs/assert/internal/spy"
+	"github.com/renormlabs/assert/match"
+)
+
+func TestThatIsEqualTo(t *testing.T) {
+	assert.That(t, 42).IsEqualTo(42)
+}
+
+func TestThatIsNotEqualTo(t *testing.T) {
+	assert.That(t, 42).IsNotEqualTo(7)
+}
+
+func TestThatIsNil(t *testing.T) {
+	var v testing.TB
+	assert.That(t, v).IsNil()
+}
+
+func TestThatIsNotNil(t *testing.T) {
+	assert.That(t, "hello").IsNotNil()
+}
+
+func TestThatChainsMultipleChecks(t *testing.T) {
+	assert.That(t, "hello").IsNotNil().IsEqualTo("hello").IsNotEqualTo("goodbye")
+}
+
+func TestThatIsEqualToFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.That(s, 42).IsEqualTo(7)
+}
+
+func TestThatIsNotEqualToFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.That(s, 42).IsNotEqualTo(42)
+}
+
+func TestThatIsNilFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.That(s, 123).IsNil()
+}
+
+func TestThatIsNotNilFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	var v any
+	assert.That(s, v).IsNotNil()
+}
+
+func TestThatStopsNeitherSideOnFailure(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.That(s, 1).IsEqualTo(2).IsEqualTo(1)
+}
+
+func TestThatWithMatcher(t *testing.T) {
+	assert.That(t, "abcxyz", match.AllOf(match.StringContaining("abc"), match.Not(match.EqualTo("abcdef"))))
+}
+
+func TestThatWithMatcherFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.That(s, "xyz", match.StringContaining("abc"))
+}
+
+func TestThatWithMatcherChainsFurtherAssertions(t *testing.T) {
+	assert.That(t, "abcxyz", match.StringContaining("abc")).IsNotNil()
+}
+
+func TestChainSatisfies(t *testing.T) {
+	assert.That(t, 5).Satisfies(match.GreaterThan(1))
+}
+
+func TestChainSatisfiesFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.That(s, 5).Satisfies(match.LessThan(1))
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/renormlabs/assert"
+	"github.com/renormlabs/assert/internal/diff"
+)
+
+func TestSetDiffRendererPlugsIntoTheSharedRenderer(t *testing.T) {
+	defer assert.SetDiffRenderer(nil)
+	assert.SetDiffRenderer(func(expected, actual any) string {
+		return "custom diff"
+	})
+	assert.Equal(t, "custom diff", diff.Describe(1, 2))
+}
+
+func TestSetDiffRendererNilRestoresTheBuiltInRenderer(t *testing.T) {
+	assert.SetDiffRenderer(func(expected, actual any) string {
+		return "custom diff"
+	})
+	assert.SetDiffRenderer(nil)
+	assert.StringContains(t, diff.Describe(1, 2), "1")
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+// [TB] is an alias for [testing.TB], spelled out for readability in fluent helpers like
+// [WithinSeconds] where the parameter is an assertion target rather than the test itself.
+type TB = testing.TB
+
+// pollInterval is how often [WithinSeconds] retries its body while waiting for it to pass.
+const pollInterval = 10 * time.Millisecond
+
+// [Eventuallyf] asserts that cond becomes true within timeout, polling every interval. If the
+// timeout elapses without cond returning true, it reports an error with the given formatting.
+func Eventuallyf(t testing.TB, cond func() bool, timeout, interval time.Duration, format string, args ...any) {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for {
+		if cond() {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Errorf(format, args...)
+			return
+		}
+		time.Sleep(interval)
+	}
+}
+
+// [Eventually] asserts that cond becomes true within timeout using [Eventuallyf] with a default message.
+func Eventually(t testing.TB, cond func() bool, timeout, interval time.Duration) {
+	t.Helper()
+	Eventuallyf(t, cond, timeout, interval, "expected condition to become true within %v", timeout)
+}
+
+// [Consistentlyf] asserts that cond remains true for the entirety of duration, polling every
+// interval. It fails as soon as cond returns false at any tick, reporting an error with the given
+// formatting.
+func Consistentlyf(t testing.TB, cond func() bool, duration, interval time.Duration, format string, args ...any) {
+	t.Helper()
+	deadline := time.Now().Add(duration)
+	for {
+		if !cond() {
+			t.Errorf(format, args...)
+			return
+		}
+		if time.Now().After(deadline) {
+			return
+		}
+		time.Sleep(interval)
+	}
+}
+
+// [Consistently] asserts that cond remains true for the entirety of duration using [Consistentlyf]
+// with a default message.
+func Consistently(t testing.TB, cond func() bool, duration, interval time.Duration) {
+	t.Helper()
+	Consistentlyf(t, cond, duration, interval, "expected condition to remain true for %v", duration)
+}
+
+// scopedTB captures failures reported against it instead of forwarding them to the wrapped TB,
+// mirroring the pattern in internal/spy. [WithinSeconds] uses it to retry body without failing
+// the real test until the retry window has expired.
+type scopedTB struct {
+	testing.TB
+	failed bool
+}
+
+func (s *scopedTB) Fail() {
+	s.TB.Helper()
+	s.failed = true
+}
+
+func (s *scopedTB) FailNow() {
+	s.TB.Helper()
+	s.failed = true
+}
+
+func (s *scopedTB) Error(args ...any) {
+	s.TB.Helper()
+	s.failed = true
+}
+
+func (s *scopedTB) Errorf(format string, args ...any) {
+	s.TB.Helper()
+	s.failed = true
+}
+
+func (s *scopedTB) Fatal(args ...any) {
+	s.TB.Helper()
+	s.failed = true
+}
+
+func (s *scopedTB) Fatalf(format string, args ...any) {
+	s.TB.Helper()
+	s.failed = true
+}
+
+// finalAttemptGrace bounds how long [WithinSeconds] waits for its last attempt — the one run
+// directly against t so its own assertions report the real failure — to finish. Without it, a
+// body that blocks forever (e.g. [by.Channelling] on a channel that never produces another value)
+// would hang WithinSeconds past its stated deadline instead of failing.
+const finalAttemptGrace = 100 * time.Millisecond
+
+// [WithinSeconds] retries body against a scoped [TB] until it reports no failures or seconds
+// elapses, whichever comes first. This is meant for asserting on asynchronous or timing-sensitive
+// values, e.g. combined with [by.Channelling]:
+//
+//	assert.WithinSeconds(t, 10, func(eventually assert.TB) {
+//		assert.Equal(eventually, by.Channelling(ch), expected)
+//	})
+//
+// Each attempt runs in its own goroutine and is bounded by the time remaining until the deadline,
+// so a body that blocks (e.g. on an exhausted channel) cannot suppress the deadline: WithinSeconds
+// still reports a failure once seconds has elapsed, even if an in-flight attempt never returns on
+// its own. On the final attempt, once seconds has elapsed, body is run once more against t
+// directly, bounded by [finalAttemptGrace], so its own assertions report the real failure.
+func WithinSeconds(t testing.TB, seconds float64, body func(eventually TB)) {
+	t.Helper()
+	timeout := time.Duration(seconds * float64(time.Second))
+	deadline := time.Now().Add(timeout)
+	for {
+		s := &scopedTB{TB: t}
+		if !runBounded(func() { body(s) }, deadline) {
+			t.Errorf("expected condition to become true within %v, but an attempt was still running when the deadline passed", timeout)
+			return
+		}
+		if !s.failed {
+			return
+		}
+		if time.Now().After(deadline) {
+			if !runBounded(func() { body(t) }, time.Now().Add(finalAttemptGrace)) {
+				t.Errorf("expected condition to become true within %v, but an attempt was still running when the deadline passed", timeout)
+			}
+			return
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// runBounded runs body in its own goroutine and reports whether it completed before deadline. If
+// it did not, the goroutine is left running; there is no way to safely abandon an arbitrary
+// blocked function in Go.
+func runBounded(body func(), deadline time.Time) bool {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		body()
+	}()
+	timeout := time.Until(deadline)
+	if timeout < 0 {
+		timeout = 0
+	}
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package assert_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/renormlabs/assert"
+	"github.com/renormlabs/assert/by"
+	"github.com/renormlabs/assert/internal/spy"
+)
+
+// Tests expected to PASS:
+func TestEventually(t *testing.T) {
+	tries := 0
+	assert.Eventually(t, func() bool {
+		tries++
+		return tries >= 3
+	}, 100*time.Millisecond, time.Millisecond)
+}
+
+func TestConsistently(t *testing.T) {
+	assert.Consistently(t, func() bool {
+		return true
+	}, 20*time.Millisecond, time.Millisecond)
+}
+
+func TestWithinSeconds(t *testing.T) {
+	ch := make(chan int, 1)
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		ch <- 42
+	}()
+	assert.WithinSeconds(t, 1, func(eventually assert.TB) {
+		assert.Equal(eventually, by.Channelling(ch), 42)
+	})
+}
+
+// Tests expected to FAIL:
+func TestEventuallyFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.Eventually(s, func() bool {
+		return false
+	}, 10*time.Millisecond, time.Millisecond)
+}
+
+func TestConsistentlyFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	tries := 0
+	assert.Consistently(s, func() bool {
+		tries++
+		return tries < 3
+	}, 20*time.Millisecond, time.Millisecond)
+}
+
+func TestWithinSecondsFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.WithinSeconds(s, 0.02, func(eventually assert.TB) {
+		assert.Equal(eventually, 1, 2)
+	})
+}
+
+func TestWithinSecondsDoesNotHangWhenBodyBlocksForever(t *testing.T) {
+	ch := make(chan int) // never sent on; by.Channelling blocks forever
+	s := spy.SpyOn(t)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.WithinSeconds(s, 0.05, func(eventually assert.TB) {
+			assert.Equal(eventually, by.Channelling(ch), 42)
+		})
+	}()
+
+	select {
+	case <-done:
+		s.ExpectFailure()
+	case <-time.After(time.Second):
+		t.Fatal("WithinSeconds did not return within its deadline when body blocked forever")
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package diff
+
+import "sync/atomic"
+
+// current is the renderer used by [Describe]. It starts out as the built-in [Render] and can be
+// swapped via [SetRenderer]. It's stored behind an atomic.Pointer so concurrent calls to
+// SetRenderer and Describe — e.g. a test binary that sets a custom renderer while other packages'
+// tests run in parallel — don't race.
+var current atomic.Pointer[func(expected, actual any) string]
+
+func init() {
+	setRenderer(Render)
+}
+
+func setRenderer(renderer func(expected, actual any) string) {
+	current.Store(&renderer)
+}
+
+// [SetRenderer] overrides the renderer used by [Describe]. Passing nil restores [Render].
+func SetRenderer(renderer func(expected, actual any) string) {
+	if renderer == nil {
+		setRenderer(Render)
+		return
+	}
+	setRenderer(renderer)
+}
+
+// [Describe] renders expected vs actual using the currently configured renderer.
+func Describe(expected, actual any) string {
+	return (*current.Load())(expected, actual)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package diff_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/renormlabs/assert"
+	"github.com/renormlabs/assert/internal/diff"
+)
+
+func TestDescribeUsesTheBuiltInRendererByDefault(t *testing.T) {
+	out := diff.Describe(1, 2)
+	assert.StringContains(t, out, "1")
+	assert.StringContains(t, out, "2")
+}
+
+func TestSetRendererOverridesDescribe(t *testing.T) {
+	defer diff.SetRenderer(nil)
+	diff.SetRenderer(func(expected, actual any) string {
+		return "custom diff"
+	})
+	assert.Equal(t, "custom diff", diff.Describe(1, 2))
+}
+
+func TestSetRendererNilRestoresTheBuiltInRenderer(t *testing.T) {
+	diff.SetRenderer(func(expected, actual any) string {
+		return "custom diff"
+	})
+	diff.SetRenderer(nil)
+	out := diff.Describe(1, 2)
+	assert.StringContains(t, out, "1")
+	assert.StringContains(t, out, "2")
+}
+
+func TestConcurrentSetRendererAndDescribeDoNotRace(t *testing.T) {
+	defer diff.SetRenderer(nil)
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			diff.SetRenderer(func(expected, actual any) string {
+				return "custom diff"
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			diff.Describe(1, 2)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+// Package diff renders a structured, human-readable diff between two values, for describing why a
+// DeepEqual-style assertion failed. The default renderer is dependency-free, walking both values
+// via reflection; callers can swap in their own via [SetRenderer].
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unsafe"
+)
+
+// visitKey identifies a pair of pointers already being compared, so [Render] can detect cycles in
+// self-referential graphs instead of recursing forever.
+type visitKey struct {
+	expected, actual uintptr
+}
+
+// [Render] is the built-in diff renderer. It walks expected and actual via reflection and emits a
+// unified, indented diff: per-field for structs, keyed for maps, and index-based for slices and
+// arrays. Unexported struct fields are not walked or printed; when a struct's unexported fields
+// differ, a single "<unexported field(s) differ>" line is emitted in their place.
+func Render(expected, actual any) string {
+	var b strings.Builder
+	r := &renderer{visited: map[visitKey]bool{}}
+	r.diff(&b, 0, reflect.ValueOf(expected), reflect.ValueOf(actual))
+	if b.Len() == 0 {
+		return "(no differences)"
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+type renderer struct {
+	visited map[visitKey]bool
+}
+
+func (r *renderer) diff(b *strings.Builder, indent int, expected, actual reflect.Value) {
+	if equalValues(expected, actual) {
+		return
+	}
+	pad := strings.Repeat("  ", indent)
+
+	if !expected.IsValid() || !actual.IsValid() || expected.Type() != actual.Type() {
+		fmt.Fprintf(b, "%s- %s\n%s+ %s\n", pad, format(expected), pad, format(actual))
+		return
+	}
+
+	switch expected.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			fmt.Fprintf(b, "%s- %s\n%s+ %s\n", pad, format(expected), pad, format(actual))
+			return
+		}
+		if expected.Kind() == reflect.Ptr {
+			key := visitKey{expected.Pointer(), actual.Pointer()}
+			if r.visited[key] {
+				return
+			}
+			r.visited[key] = true
+		}
+		r.diff(b, indent, expected.Elem(), actual.Elem())
+
+	case reflect.Struct:
+		t := expected.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			ef, af := expected.Field(i), actual.Field(i)
+			if !equalValues(ef, af) {
+				fmt.Fprintf(b, "%s%s:\n", pad, f.Name)
+				r.diff(b, indent+1, ef, af)
+			}
+		}
+		if unexportedFieldsDiffer(expected, actual) {
+			fmt.Fprintf(b, "%s<unexported field(s) differ>\n", pad)
+		}
+
+	case reflect.Map:
+		key := visitKey{expected.Pointer(), actual.Pointer()}
+		if r.visited[key] {
+			return
+		}
+		r.visited[key] = true
+		for _, k := range unionMapKeys(expected, actual) {
+			kv := reflect.ValueOf(k)
+			ev, av := expected.MapIndex(kv), actual.MapIndex(kv)
+			if !equalValues(ev, av) {
+				fmt.Fprintf(b, "%s[%v]:\n", pad, k)
+				r.diff(b, indent+1, ev, av)
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		if expected.Kind() == reflect.Slice {
+			key := visitKey{expected.Pointer(), actual.Pointer()}
+			if r.visited[key] {
+				return
+			}
+			r.visited[key] = true
+		}
+		n := max(expected.Len(), actual.Len())
+		for i := 0; i < n; i++ {
+			ev, av := index(expected, i), index(actual, i)
+			if !equalValues(ev, av) {
+				fmt.Fprintf(b, "%s[%d]:\n", pad, i)
+				r.diff(b, indent+1, ev, av)
+			}
+		}
+
+	default:
+		fmt.Fprintf(b, "%s- %s\n%s+ %s\n", pad, format(expected), pad, format(actual))
+	}
+}
+
+// index returns v.Index(i), or the zero Value if i is out of range.
+func index(v reflect.Value, i int) reflect.Value {
+	if i >= v.Len() {
+		return reflect.Value{}
+	}
+	return v.Index(i)
+}
+
+// unionMapKeys returns the sorted union of a and b's keys, formatted so iteration order is
+// deterministic.
+func unionMapKeys(a, b reflect.Value) []any {
+	seen := map[string]any{}
+	for _, v := range a.MapKeys() {
+		seen[fmt.Sprintf("%v", v.Interface())] = v.Interface()
+	}
+	for _, v := range b.MapKeys() {
+		seen[fmt.Sprintf("%v", v.Interface())] = v.Interface()
+	}
+	formatted := make([]string, 0, len(seen))
+	for f := range seen {
+		formatted = append(formatted, f)
+	}
+	sort.Strings(formatted)
+	keys := make([]any, len(formatted))
+	for i, f := range formatted {
+		keys[i] = seen[f]
+	}
+	return keys
+}
+
+// unexportedFieldsDiffer reports whether expected and actual, both of the same struct type, differ
+// in any unexported field. Field values can't be read back out via Interface() once the walk has
+// descended into them (see the Struct case in diff), which would otherwise make two structs that
+// differ only in an unexported field silently render as having no differences even though
+// DeepEqual correctly considers them unequal. Addressable copies plus unsafe.Pointer sidestep that
+// restriction; this is only used to detect a difference, never to print the field's value.
+func unexportedFieldsDiffer(expected, actual reflect.Value) bool {
+	t := expected.Type()
+	ec := reflect.New(t).Elem()
+	ec.Set(expected)
+	ac := reflect.New(t).Elem()
+	ac.Set(actual)
+
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).PkgPath == "" {
+			continue
+		}
+		ef := reflect.NewAt(t.Field(i).Type, unsafe.Pointer(ec.Field(i).UnsafeAddr())).Elem()
+		af := reflect.NewAt(t.Field(i).Type, unsafe.Pointer(ac.Field(i).UnsafeAddr())).Elem()
+		if !reflect.DeepEqual(ef.Interface(), af.Interface()) {
+			return true
+		}
+	}
+	return false
+}
+
+// equalValues reports whether a and b are equal, treating two invalid (missing) values as equal
+// and a missing value as unequal to a present one.
+func equalValues(a, b reflect.Value) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if !a.CanInterface() || !b.CanInterface() {
+		return true
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+func format(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<missing>"
+	}
+	return fmt.Sprintf("%v", v.Interface())
+}
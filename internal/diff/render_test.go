@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package diff_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/renormlabs/assert"
+	"github.com/renormlabs/assert/internal/diff"
+)
+
+func TestRenderScalars(t *testing.T) {
+	out := diff.Render(1, 2)
+	assert.StringContains(t, out, "1")
+	assert.StringContains(t, out, "2")
+}
+
+func TestRenderNoDifferences(t *testing.T) {
+	out := diff.Render(1, 1)
+	assert.Equal(t, "(no differences)", out)
+}
+
+func TestRenderStructFields(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	out := diff.Render(person{Name: "Alice", Age: 30}, person{Name: "Alice", Age: 31})
+	assert.StringContains(t, out, "Age")
+	assert.StringContains(t, out, "30")
+	assert.StringContains(t, out, "31")
+	assert.StringDoesNotContain(t, out, "Name")
+}
+
+func TestRenderFlagsStructsThatDifferOnlyByUnexportedField(t *testing.T) {
+	type withUnexported struct {
+		hidden int
+	}
+	out := diff.Render(withUnexported{hidden: 1}, withUnexported{hidden: 2})
+	assert.NotEqual(t, "(no differences)", out)
+	assert.StringContains(t, out, "unexported")
+}
+
+func TestRenderNestedStructFields(t *testing.T) {
+	type address struct {
+		City string
+	}
+	type person struct {
+		Name    string
+		Address address
+	}
+	out := diff.Render(
+		person{Name: "Alice", Address: address{City: "Springfield"}},
+		person{Name: "Alice", Address: address{City: "Shelbyville"}},
+	)
+	assert.StringContains(t, out, "Address")
+	assert.StringContains(t, out, "City")
+	assert.StringContains(t, out, "Springfield")
+	assert.StringContains(t, out, "Shelbyville")
+}
+
+func TestRenderMapKeys(t *testing.T) {
+	out := diff.Render(map[string]int{"foo": 1, "bar": 2}, map[string]int{"foo": 1, "bar": 3})
+	assert.StringContains(t, out, "[bar]")
+	assert.StringDoesNotContain(t, out, "[foo]")
+}
+
+func TestRenderSliceIndices(t *testing.T) {
+	out := diff.Render([]int{1, 2, 3}, []int{1, 5, 3})
+	assert.StringContains(t, out, "[1]")
+	assert.StringDoesNotContain(t, out, "[0]")
+	assert.StringDoesNotContain(t, out, "[2]")
+}
+
+func TestRenderSliceLengthMismatch(t *testing.T) {
+	out := diff.Render([]int{1, 2}, []int{1, 2, 3})
+	assert.StringContains(t, out, "[2]")
+}
+
+func TestRenderDetectsCycles(t *testing.T) {
+	type node struct {
+		Value int
+		Next  *node
+	}
+	a := &node{Value: 1}
+	a.Next = a
+	b := &node{Value: 2}
+	b.Next = b
+
+	done := make(chan string, 1)
+	go func() {
+		done <- diff.Render(a, b)
+	}()
+	select {
+	case out := <-done:
+		assert.StringContains(t, out, "1")
+		assert.StringContains(t, out, "2")
+	case <-time.After(time.Second):
+		t.Fatal("Render did not terminate on a self-referential graph")
+	}
+}
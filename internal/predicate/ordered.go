@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package predicate
+
+import (
+	"cmp"
+	"math"
+)
+
+// Greater reports whether a is greater than b.
+func Greater[T cmp.Ordered](a, b T) bool {
+	return a > b
+}
+
+// GreaterOrEqual reports whether a is greater than or equal to b.
+func GreaterOrEqual[T cmp.Ordered](a, b T) bool {
+	return a >= b
+}
+
+// Less reports whether a is less than b.
+func Less[T cmp.Ordered](a, b T) bool {
+	return a < b
+}
+
+// LessOrEqual reports whether a is less than or equal to b.
+func LessOrEqual[T cmp.Ordered](a, b T) bool {
+	return a <= b
+}
+
+// Between reports whether value falls within [low, high], inclusive.
+func Between[T cmp.Ordered](value, low, high T) bool {
+	return value >= low && value <= high
+}
+
+// InDelta reports whether got and want differ by no more than tolerance. NaN in either argument
+// is always a failure; +Inf/-Inf only matches an equal infinity on the other side.
+func InDelta(got, want, tolerance float64) bool {
+	if math.IsNaN(got) || math.IsNaN(want) {
+		return false
+	}
+	if math.IsInf(got, 0) || math.IsInf(want, 0) {
+		return got == want
+	}
+	return math.Abs(got-want) <= tolerance
+}
+
+// InEpsilon reports whether the relative error between got and want, |got-want|/|want|, is no
+// more than epsilon. NaN in either argument is always a failure; +Inf/-Inf only matches an equal
+// infinity on the other side.
+func InEpsilon(got, want, epsilon float64) bool {
+	if math.IsNaN(got) || math.IsNaN(want) {
+		return false
+	}
+	if math.IsInf(got, 0) || math.IsInf(want, 0) {
+		return got == want
+	}
+	if want == 0 {
+		return got == 0
+	}
+	return math.Abs(got-want)/math.Abs(want) <= epsilon
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+// Package predicate holds the boolean conditions that back the assertions in [assert] and
+// [require]. Keeping the conditions here, independent of how a failure is reported, lets both
+// packages (and the fluent chain in assert) share a single implementation instead of drifting
+// apart over time.
+package predicate
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// Equal reports whether expected and actual are equal.
+func Equal[T comparable](expected, actual T) bool {
+	return expected == actual
+}
+
+// NotEqual reports whether expected and actual are not equal.
+func NotEqual[T comparable](expected, actual T) bool {
+	return expected != actual
+}
+
+// Nil reports whether actual is nil.
+func Nil(actual any) bool {
+	return actual == nil
+}
+
+// NotNil reports whether actual is not nil.
+func NotNil(actual any) bool {
+	return actual != nil
+}
+
+// StringContains reports whether str contains substr.
+func StringContains(str, substr string) bool {
+	return strings.Contains(str, substr)
+}
+
+// StringDoesNotContain reports whether str does not contain substr.
+func StringDoesNotContain(str, substr string) bool {
+	return !strings.Contains(str, substr)
+}
+
+// Panics runs f and reports whether it panicked, along with the recovered value.
+func Panics(f func()) (panicked bool, recovered any) {
+	defer func() {
+		recovered = recover()
+		panicked = recovered != nil
+	}()
+	f()
+	return
+}
+
+// DoesNotPanic runs f and reports whether it did not panic.
+func DoesNotPanic(f func()) bool {
+	panicked, _ := Panics(f)
+	return !panicked
+}
+
+// ErrorIs reports whether err is target per [errors.Is].
+func ErrorIs(err, target error) bool {
+	return errors.Is(err, target)
+}
+
+// ErrorIsNot reports whether err is not target per [errors.Is].
+func ErrorIsNot(err, target error) bool {
+	return !errors.Is(err, target)
+}
+
+// MapContainsKey reports whether m contains key.
+func MapContainsKey[K comparable, V any](m map[K]V, key K) bool {
+	_, ok := m[key]
+	return ok
+}
+
+// MapDoesNotContainKey reports whether m does not contain key.
+func MapDoesNotContainKey[K comparable, V any](m map[K]V, key K) bool {
+	_, ok := m[key]
+	return !ok
+}
+
+// EmptyMap reports whether m is empty.
+func EmptyMap[K comparable, V any](m map[K]V) bool {
+	return len(m) == 0
+}
+
+// NotEmptyMap reports whether m is not empty.
+func NotEmptyMap[K comparable, V any](m map[K]V) bool {
+	return len(m) != 0
+}
+
+// EmptySlice reports whether slice is empty.
+func EmptySlice[T any](slice []T) bool {
+	return len(slice) == 0
+}
+
+// NotEmptySlice reports whether slice is not empty.
+func NotEmptySlice[T any](slice []T) bool {
+	return len(slice) != 0
+}
+
+// DeepEqual reports whether expected and actual are equal per [reflect.DeepEqual].
+func DeepEqual[T any](expected, actual T) bool {
+	return reflect.DeepEqual(expected, actual)
+}
+
+// NotDeepEqual reports whether expected and actual are not equal per [reflect.DeepEqual].
+func NotDeepEqual[T any](expected, actual T) bool {
+	return !reflect.DeepEqual(expected, actual)
+}
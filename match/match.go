@@ -0,0 +1,67 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+// Package match provides composable, Hamcrest-style predicates for describing what a value should
+// look like. A [Matcher] both tests a value and explains why it failed, so combinators like
+// [AllOf] and [AnyOf] can aggregate a single, readable failure message out of several matchers.
+//
+// Generics are used in place of reflection wherever possible, so a mismatch between the value
+// under test and the matcher's type is a compile error rather than a failure at test time.
+package match
+
+import "fmt"
+
+// [Matcher] reports whether v satisfies some condition. When it does not, the returned string
+// describes why, for inclusion in an aggregated failure message.
+type Matcher[T any] interface {
+	Match(v T) (bool, string)
+}
+
+// matcherFunc adapts a plain function to the [Matcher] interface.
+type matcherFunc[T any] func(v T) (bool, string)
+
+func (f matcherFunc[T]) Match(v T) (bool, string) {
+	return f(v)
+}
+
+// [AllOf] matches when every one of matchers matches. On failure, the message lists the
+// description of every sub-matcher that failed.
+func AllOf[T any](matchers ...Matcher[T]) Matcher[T] {
+	return matcherFunc[T](func(v T) (bool, string) {
+		var failures []string
+		for _, m := range matchers {
+			if ok, msg := m.Match(v); !ok {
+				failures = append(failures, msg)
+			}
+		}
+		if len(failures) == 0 {
+			return true, ""
+		}
+		return false, fmt.Sprintf("expected %v to satisfy all of: %v", v, failures)
+	})
+}
+
+// [AnyOf] matches when at least one of matchers matches. On failure, the message lists the
+// description of every sub-matcher that failed.
+func AnyOf[T any](matchers ...Matcher[T]) Matcher[T] {
+	return matcherFunc[T](func(v T) (bool, string) {
+		var failures []string
+		for _, m := range matchers {
+			ok, msg := m.Match(v)
+			if ok {
+				return true, ""
+			}
+			failures = append(failures, msg)
+		}
+		return false, fmt.Sprintf("expected %v to satisfy any of: %v", v, failures)
+	})
+}
+
+// [Not] inverts m: it matches when m does not.
+func Not[T any](m Matcher[T]) Matcher[T] {
+	return matcherFunc[T](func(v T) (bool, string) {
+		if ok, _ := m.Match(v); ok {
+			return false, fmt.Sprintf("expected %v to not satisfy the inverted matcher", v)
+		}
+		return true, ""
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package match_test
+
+import (
+	"testing"
+
+	"github.com/renormlabs/assert"
+	"github.com/renormlabs/assert/match"
+)
+
+func TestAllOfMatches(t *testing.T) {
+	m := match.AllOf(match.StringContaining("abc"), match.Not(match.EqualTo("abcdef")))
+	ok, msg := m.Match("abcxyz")
+	assert.True(t, ok)
+	assert.Equal(t, "", msg)
+}
+
+func TestAllOfFailsWhenOneSubMatcherFails(t *testing.T) {
+	m := match.AllOf(match.StringContaining("abc"), match.Not(match.EqualTo("abcdef")))
+	ok, msg := m.Match("abcdef")
+	assert.False(t, ok)
+	assert.StringContains(t, msg, "abcdef")
+}
+
+func TestAnyOfMatchesWhenOneSubMatcherMatches(t *testing.T) {
+	m := match.AnyOf(match.EqualTo("foo"), match.EqualTo("bar"))
+	ok, _ := m.Match("bar")
+	assert.True(t, ok)
+}
+
+func TestAnyOfFailsWhenNoSubMatcherMatches(t *testing.T) {
+	m := match.AnyOf(match.EqualTo("foo"), match.EqualTo("bar"))
+	ok, msg := m.Match("baz")
+	assert.False(t, ok)
+	assert.StringContains(t, msg, "foo")
+	assert.StringContains(t, msg, "bar")
+}
+
+func TestNotInvertsAFailingMatcher(t *testing.T) {
+	m := match.Not(match.EqualTo("foo"))
+	ok, _ := m.Match("bar")
+	assert.True(t, ok)
+}
+
+func TestNotInvertsAMatchingMatcher(t *testing.T) {
+	m := match.Not(match.EqualTo("foo"))
+	ok, _ := m.Match("foo")
+	assert.False(t, ok)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package match
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/renormlabs/assert/internal/diff"
+	"github.com/renormlabs/assert/internal/predicate"
+)
+
+// [EqualTo] matches a value equal to want.
+func EqualTo[T comparable](want T) Matcher[T] {
+	return matcherFunc[T](func(v T) (bool, string) {
+		if predicate.Equal(want, v) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("expected %v to equal %v", v, want)
+	})
+}
+
+// [DeepEqualTo] matches a value equal to want per [reflect.DeepEqual]. On failure, the description
+// includes a structured diff of want and the value under test; see [assert.SetDiffRenderer].
+func DeepEqualTo[T any](want T) Matcher[T] {
+	return matcherFunc[T](func(v T) (bool, string) {
+		if predicate.DeepEqual(want, v) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("expected values to be equal:\n%s", diff.Describe(want, v))
+	})
+}
+
+// [StringContaining] matches a string containing substr.
+func StringContaining(substr string) Matcher[string] {
+	return matcherFunc[string](func(v string) (bool, string) {
+		if strings.Contains(v, substr) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("expected %q to contain the substring %q", v, substr)
+	})
+}
+
+// [MapContaining] matches a map containing key.
+func MapContaining[K comparable, V any](key K) Matcher[map[K]V] {
+	return matcherFunc[map[K]V](func(v map[K]V) (bool, string) {
+		if predicate.MapContainsKey(v, key) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("expected map %v to contain key %v", v, key)
+	})
+}
+
+// [MapContainingValues] matches a map containing value among its values.
+func MapContainingValues[K comparable, V comparable](value V) Matcher[map[K]V] {
+	return matcherFunc[map[K]V](func(v map[K]V) (bool, string) {
+		for _, got := range v {
+			if got == value {
+				return true, ""
+			}
+		}
+		return false, fmt.Sprintf("expected map %v to contain value %v", v, value)
+	})
+}
+
+// [ArrayContaining] matches a slice containing elem.
+func ArrayContaining[T comparable](elem T) Matcher[[]T] {
+	return matcherFunc[[]T](func(v []T) (bool, string) {
+		for _, got := range v {
+			if got == elem {
+				return true, ""
+			}
+		}
+		return false, fmt.Sprintf("expected slice %v to contain %v", v, elem)
+	})
+}
+
+// [HasLength] matches a value with exactly n elements. T must be a type [reflect.Value.Len] accepts:
+// an array, slice, map, string, or channel.
+func HasLength[T any](n int) Matcher[T] {
+	return matcherFunc[T](func(v T) (bool, string) {
+		length := reflect.ValueOf(v).Len()
+		if length == n {
+			return true, ""
+		}
+		return false, fmt.Sprintf("expected %v to have length %d, got %d", v, n, length)
+	})
+}
+
+// [GreaterThan] matches a value greater than bound.
+func GreaterThan[T cmp.Ordered](bound T) Matcher[T] {
+	return matcherFunc[T](func(v T) (bool, string) {
+		if predicate.Greater(v, bound) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("expected %v to be greater than %v", v, bound)
+	})
+}
+
+// [LessThan] matches a value less than bound.
+func LessThan[T cmp.Ordered](bound T) Matcher[T] {
+	return matcherFunc[T](func(v T) (bool, string) {
+		if predicate.Less(v, bound) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("expected %v to be less than %v", v, bound)
+	})
+}
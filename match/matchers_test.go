@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package match_test
+
+import (
+	"testing"
+
+	"github.com/renormlabs/assert"
+	"github.com/renormlabs/assert/match"
+)
+
+func TestEqualTo(t *testing.T) {
+	ok, _ := match.EqualTo(42).Match(42)
+	assert.True(t, ok)
+	ok, msg := match.EqualTo(42).Match(7)
+	assert.False(t, ok)
+	assert.NotEqual(t, "", msg)
+}
+
+func TestDeepEqualTo(t *testing.T) {
+	type person struct {
+		Name string
+	}
+	ok, _ := match.DeepEqualTo(person{Name: "Alice"}).Match(person{Name: "Alice"})
+	assert.True(t, ok)
+	ok, _ = match.DeepEqualTo(person{Name: "Alice"}).Match(person{Name: "Bob"})
+	assert.False(t, ok)
+}
+
+func TestStringContaining(t *testing.T) {
+	ok, _ := match.StringContaining("abc").Match("xabcy")
+	assert.True(t, ok)
+	ok, _ = match.StringContaining("abc").Match("xyz")
+	assert.False(t, ok)
+}
+
+func TestMapContaining(t *testing.T) {
+	m := map[string]int{"foo": 1}
+	ok, _ := match.MapContaining[string, int]("foo").Match(m)
+	assert.True(t, ok)
+	ok, _ = match.MapContaining[string, int]("bar").Match(m)
+	assert.False(t, ok)
+}
+
+func TestMapContainingValues(t *testing.T) {
+	m := map[string]int{"foo": 1}
+	ok, _ := match.MapContainingValues[string](1).Match(m)
+	assert.True(t, ok)
+	ok, _ = match.MapContainingValues[string](2).Match(m)
+	assert.False(t, ok)
+}
+
+func TestArrayContaining(t *testing.T) {
+	ok, _ := match.ArrayContaining(2).Match([]int{1, 2, 3})
+	assert.True(t, ok)
+	ok, _ = match.ArrayContaining(5).Match([]int{1, 2, 3})
+	assert.False(t, ok)
+}
+
+func TestHasLength(t *testing.T) {
+	ok, _ := match.HasLength[[]int](3).Match([]int{1, 2, 3})
+	assert.True(t, ok)
+	ok, msg := match.HasLength[[]int](5).Match([]int{1, 2, 3})
+	assert.False(t, ok)
+	assert.StringContains(t, msg, "3")
+}
+
+func TestGreaterThan(t *testing.T) {
+	ok, _ := match.GreaterThan(1).Match(2)
+	assert.True(t, ok)
+	ok, _ = match.GreaterThan(1).Match(1)
+	assert.False(t, ok)
+}
+
+func TestLessThan(t *testing.T) {
+	ok, _ := match.LessThan(2).Match(1)
+	assert.True(t, ok)
+	ok, _ = match.LessThan(2).Match(2)
+	assert.False(t, ok)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package assert_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/renormlabs/assert"
+	"github.com/renormlabs/assert/internal/spy"
+)
+
+// Tests expected to PASS:
+func TestGreater(t *testing.T) {
+	assert.Greater(t, 2, 1)
+}
+func TestGreaterOrEqual(t *testing.T) {
+	assert.GreaterOrEqual(t, 2, 2)
+}
+func TestLess(t *testing.T) {
+	assert.Less(t, 1, 2)
+}
+func TestLessOrEqual(t *testing.T) {
+	assert.LessOrEqual(t, 2, 2)
+}
+func TestBetween(t *testing.T) {
+	assert.Between(t, 5, 1, 10)
+}
+func TestInDelta(t *testing.T) {
+	assert.InDelta(t, 1.0001, 1.0, 0.001)
+}
+func TestInDeltaMatchingInfinity(t *testing.T) {
+	assert.InDelta(t, math.Inf(1), math.Inf(1), 0.001)
+}
+func TestInEpsilon(t *testing.T) {
+	assert.InEpsilon(t, 101.0, 100.0, 0.02)
+}
+func TestInEpsilonMatchingInfinity(t *testing.T) {
+	assert.InEpsilon(t, math.Inf(-1), math.Inf(-1), 0.001)
+}
+
+// Tests expected to FAIL:
+func TestGreaterFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.Greater(s, 1, 2)
+}
+func TestGreaterOrEqualFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.GreaterOrEqual(s, 1, 2)
+}
+func TestLessFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.Less(s, 2, 1)
+}
+func TestLessOrEqualFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.LessOrEqual(s, 2, 1)
+}
+func TestBetweenFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.Between(s, 15, 1, 10)
+}
+func TestInDeltaFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.InDelta(s, 2.0, 1.0, 0.5)
+}
+func TestInDeltaFailsOnNaN(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.InDelta(s, math.NaN(), 1.0, 100)
+}
+func TestInDeltaFailsOnMismatchedInfinity(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.InDelta(s, math.Inf(1), math.Inf(-1), 100)
+}
+func TestInEpsilonFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.InEpsilon(s, 150.0, 100.0, 0.1)
+}
+func TestInEpsilonFailsOnNaN(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	assert.InEpsilon(s, math.NaN(), 1.0, 100)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package require
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/renormlabs/assert/internal/predicate"
+)
+
+// [Greaterf] asserts that a is greater than b. If it is not, it reports a fatal error with the given formatting.
+func Greaterf[T cmp.Ordered](t testing.TB, a, b T, format string, args ...any) {
+	t.Helper()
+	if !predicate.Greater(a, b) {
+		t.Fatalf(format, args...)
+	}
+}
+
+// [Greater] asserts that a is greater than b using [Greaterf] with a default message.
+func Greater[T cmp.Ordered](t testing.TB, a, b T) {
+	t.Helper()
+	Greaterf(t, a, b, "expected %v to be greater than %v", a, b)
+}
+
+// [GreaterOrEqualf] asserts that a is greater than or equal to b. If it is not, it reports a fatal error with the given formatting.
+func GreaterOrEqualf[T cmp.Ordered](t testing.TB, a, b T, format string, args ...any) {
+	t.Helper()
+	if !predicate.GreaterOrEqual(a, b) {
+		t.Fatalf(format, args...)
+	}
+}
+
+// [GreaterOrEqual] asserts that a is greater than or equal to b using [GreaterOrEqualf] with a default message.
+func GreaterOrEqual[T cmp.Ordered](t testing.TB, a, b T) {
+	t.Helper()
+	GreaterOrEqualf(t, a, b, "expected %v to be greater than or equal to %v", a, b)
+}
+
+// [Lessf] asserts that a is less than b. If it is not, it reports a fatal error with the given formatting.
+func Lessf[T cmp.Ordered](t testing.TB, a, b T, format string, args ...any) {
+	t.Helper()
+	if !predicate.Less(a, b) {
+		t.Fatalf(format, args...)
+	}
+}
+
+// [Less] asserts that a is less than b using [Lessf] with a default message.
+func Less[T cmp.Ordered](t testing.TB, a, b T) {
+	t.Helper()
+	Lessf(t, a, b, "expected %v to be less than %v", a, b)
+}
+
+// [LessOrEqualf] asserts that a is less than or equal to b. If it is not, it reports a fatal error with the given formatting.
+func LessOrEqualf[T cmp.Ordered](t testing.TB, a, b T, format string, args ...any) {
+	t.Helper()
+	if !predicate.LessOrEqual(a, b) {
+		t.Fatalf(format, args...)
+	}
+}
+
+// [LessOrEqual] asserts that a is less than or equal to b using [LessOrEqualf] with a default message.
+func LessOrEqual[T cmp.Ordered](t testing.TB, a, b T) {
+	t.Helper()
+	LessOrEqualf(t, a, b, "expected %v to be less than or equal to %v", a, b)
+}
+
+// [Betweenf] asserts that value falls within [low, high], inclusive. If it does not, it reports a fatal error with the given formatting.
+func Betweenf[T cmp.Ordered](t testing.TB, value, low, high T, format string, args ...any) {
+	t.Helper()
+	if !predicate.Between(value, low, high) {
+		t.Fatalf(format, args...)
+	}
+}
+
+// [Between] asserts that value falls within [low, high], inclusive, using [Betweenf] with a default message.
+func Between[T cmp.Ordered](t testing.TB, value, low, high T) {
+	t.Helper()
+	Betweenf(t, value, low, high, "expected %v to be between %v and %v", value, low, high)
+}
+
+// [InDeltaf] asserts that got and want differ by no more than tolerance, i.e. math.Abs(got-want) <= tolerance.
+// If they do not, it reports a fatal error with the given formatting.
+func InDeltaf(t testing.TB, got, want, tolerance float64, format string, args ...any) {
+	t.Helper()
+	if !predicate.InDelta(got, want, tolerance) {
+		t.Fatalf(format, args...)
+	}
+}
+
+// [InDelta] asserts that got and want differ by no more than tolerance using [InDeltaf] with a default message.
+func InDelta(t testing.TB, got, want, tolerance float64) {
+	t.Helper()
+	InDeltaf(t, got, want, tolerance, "expected %v to be within %v of %v", got, tolerance, want)
+}
+
+// [InEpsilonf] asserts that the relative error between got and want, |got-want|/|want|, is no more
+// than epsilon. If it is not, it reports a fatal error with the given formatting.
+func InEpsilonf(t testing.TB, got, want, epsilon float64, format string, args ...any) {
+	t.Helper()
+	if !predicate.InEpsilon(got, want, epsilon) {
+		t.Fatalf(format, args...)
+	}
+}
+
+// [InEpsilon] asserts that the relative error between got and want is no more than epsilon using
+// [InEpsilonf] with a default message.
+func InEpsilon(t testing.TB, got, want, epsilon float64) {
+	t.Helper()
+	InEpsilonf(t, got, want, epsilon, "expected %v to be within relative error %v of %v", got, epsilon, want)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package require_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/renormlabs/assert/internal/spy"
+	"github.com/renormlabs/assert/require"
+)
+
+// Tests expected to PASS:
+func TestGreater(t *testing.T) {
+	require.Greater(t, 2, 1)
+}
+func TestGreaterOrEqual(t *testing.T) {
+	require.GreaterOrEqual(t, 2, 2)
+}
+func TestLess(t *testing.T) {
+	require.Less(t, 1, 2)
+}
+func TestLessOrEqual(t *testing.T) {
+	require.LessOrEqual(t, 2, 2)
+}
+func TestBetween(t *testing.T) {
+	require.Between(t, 5, 1, 10)
+}
+func TestInDelta(t *testing.T) {
+	require.InDelta(t, 1.0001, 1.0, 0.001)
+}
+func TestInEpsilon(t *testing.T) {
+	require.InEpsilon(t, 101.0, 100.0, 0.02)
+}
+
+// Tests expected to FAIL:
+func TestGreaterFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.Greater(s, 1, 2)
+}
+func TestGreaterOrEqualFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.GreaterOrEqual(s, 1, 2)
+}
+func TestLessFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.Less(s, 2, 1)
+}
+func TestLessOrEqualFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.LessOrEqual(s, 2, 1)
+}
+func TestBetweenFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.Between(s, 15, 1, 10)
+}
+func TestInDeltaFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.InDelta(s, 2.0, 1.0, 0.5)
+}
+func TestInDeltaFailsOnNaN(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.InDelta(s, math.NaN(), 1.0, 100)
+}
+func TestInEpsilonFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.InEpsilon(s, 150.0, 100.0, 0.1)
+}
@@ -0,0 +1,315 @@
+// Copyright (c) 2025 Renorm Labs. All rights reserved.
+
+package require_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/renormlabs/assert/internal/spy"
+	"github.com/renormlabs/assert/require"
+)
+
+var errToCompare = errors.New("test error")
+
+// Tests expected to PASS:
+func TestEqual(t *testing.T) {
+	require.Equal(t, 42, 42)
+}
+func TestNotEqual(t *testing.T) {
+	require.NotEqual(t, 1, 2)
+}
+func TestTrue(t *testing.T) {
+	require.True(t, true)
+}
+func TestFalse(t *testing.T) {
+	require.False(t, false)
+}
+func TestNil(t *testing.T) {
+	var v testing.TB
+	require.Nil(t, v)
+}
+func TestNotNil(t *testing.T) {
+	require.NotNil(t, "hello")
+}
+func TestStringContains(t *testing.T) {
+	require.StringContains(t, "golang testing helpers", "testing")
+}
+func TestStringDoesNotContain(t *testing.T) {
+	require.StringDoesNotContain(t, "golang testing helpers", "foo")
+}
+func TestPanics(t *testing.T) {
+	f := func() {
+		panic("test")
+	}
+	require.Panics(t, f)
+}
+func TestDoesNotPanic(t *testing.T) {
+	f := func() {}
+	require.DoesNotPanic(t, f)
+}
+func TestErrorIs(t *testing.T) {
+	f := func() error {
+		return fmt.Errorf("foo: %w", errToCompare)
+	}
+	require.ErrorIs(t, f(), errToCompare)
+}
+func TestErrorIsNot(t *testing.T) {
+	f := func() error {
+		return fmt.Errorf("test error")
+	}
+	require.ErrorIsNot(t, f(), errToCompare)
+	require.Equal(t, f().Error(), errToCompare.Error())
+}
+
+func TestMapContainsKey(t *testing.T) {
+	m := map[string]string{
+		"foo": "bar",
+	}
+	require.MapContainsKey(t, m, "foo")
+}
+
+func TestMapDoesNotContainKey(t *testing.T) {
+	m := map[string]string{
+		"foo": "bar",
+	}
+	require.MapDoesNotContainKey(t, m, "baz")
+}
+
+func TestEmptyMap(t *testing.T) {
+	m := map[string]string{}
+	require.EmptyMap(t, m)
+}
+
+func TestNotEmptyMap(t *testing.T) {
+	m := map[string]string{
+		"foo": "bar",
+	}
+	require.NotEmptyMap(t, m)
+}
+
+func TestEmptySlice(t *testing.T) {
+	s := []string{}
+	require.EmptySlice(t, s)
+}
+
+func TestNotEmptySlice(t *testing.T) {
+	s := []string{"foo", "bar"}
+	require.NotEmptySlice(t, s)
+}
+
+func TestDeepEqual(t *testing.T) {
+	type person struct {
+		Name    string
+		Age     int
+		Friends []person
+	}
+	p1 := person{
+		Name:    "Alice",
+		Age:     30,
+		Friends: []person{{Name: "Bob", Age: 25}},
+	}
+	p2 := person{
+		Name:    "Alice",
+		Age:     30,
+		Friends: []person{{Name: "Bob", Age: 25}},
+	}
+	require.DeepEqual(t, p1, p2)
+	require.NotEqual(t, &p1, &p2)
+}
+
+func TestNotDeepEqual(t *testing.T) {
+	type person struct {
+		Name    string
+		Age     int
+		Friends []person
+	}
+	p1 := person{
+		Name:    "Alice",
+		Age:     30,
+		Friends: []person{{Name: "Bob", Age: 25}},
+	}
+	p2 := person{
+		Name:    "Alice",
+		Age:     30,
+		Friends: []person{{Name: "Bob", Age: 26}},
+	}
+	require.NotDeepEqual(t, p1, p2)
+}
+
+// Tests expected to FAIL:
+// These mirror the pattern used by the assert package's own tests: a spy TB captures the
+// Fatalf() call instead of halting the goroutine, so we can assert that it happened.
+
+func TestTrueFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.True(s, false)
+}
+
+func TestFalseFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.False(s, true)
+}
+
+func TestEqualFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.Equal(s, 1, 2)
+}
+
+func TestNotEqualFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.NotEqual(s, "same", "same")
+}
+
+func TestNilFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.Nil(s, 123)
+}
+
+func TestNotNilFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.NotNil(s, nil)
+}
+
+func TestStringContainsFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.StringContains(s, "golang testing helpers", "python")
+}
+
+func TestStringDoesNotContainFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	require.StringDoesNotContain(s, "golang testing helpers", "helpers")
+}
+
+func TestPanicsFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	f := func() {}
+	require.Panics(s, f)
+}
+
+func TestDoesNotPanicFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	f := func() {
+		panic("test")
+	}
+	require.DoesNotPanic(s, f)
+}
+
+func TestErrorIsFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	f := func() error {
+		return fmt.Errorf("foo: %w", errToCompare)
+	}
+	require.ErrorIs(s, f(), errors.New("not the same"))
+}
+
+func TestErrorIsNotFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	f := func() error {
+		return fmt.Errorf("foo: %w", errToCompare)
+	}
+	require.ErrorIsNot(s, f(), errToCompare)
+}
+
+func TestMapContainsKeyFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	m := map[string]string{
+		"foo": "bar",
+	}
+	require.MapContainsKey(s, m, "baz")
+}
+
+func TestMapDoesNotContainKeyFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	m := map[string]string{
+		"foo": "bar",
+	}
+	require.MapDoesNotContainKey(s, m, "foo")
+}
+
+func TestEmptyMapFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	m := map[string]string{
+		"foo": "bar",
+	}
+	require.EmptyMap(s, m)
+}
+
+func TestNotEmptyMapFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	m := map[string]string{}
+	require.NotEmptyMap(s, m)
+}
+
+func TestEmptySliceFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	slice := []string{"foo", "bar"}
+	require.EmptySlice(s, slice)
+}
+
+func TestNotEmptySliceFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	slice := []string{}
+	require.NotEmptySlice(s, slice)
+}
+
+func TestDeepEqualFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	type person struct {
+		Name    string
+		Age     int
+		Friends []person
+	}
+	p1 := person{
+		Name:    "Alice",
+		Age:     30,
+		Friends: []person{{Name: "Bob", Age: 25}},
+	}
+	p2 := person{
+		Name:    "Alice",
+		Age:     30,
+		Friends: []person{{Name: "Charlie", Age: 25}},
+	}
+	require.DeepEqual(s, p1, p2)
+}
+
+func TestNotDeepEqualFails(t *testing.T) {
+	s := spy.SpyOn(t)
+	defer s.ExpectFailure()
+	type person struct {
+		Name    string
+		Age     int
+		Friends []person
+	}
+	p1 := person{
+		Name:    "Alice",
+		Age:     30,
+		Friends: []person{{Name: "Bob", Age: 25}},
+	}
+	p2 := person{
+		Name:    "Alice",
+		Age:     30,
+		Friends: []person{{Name: "Bob", Age: 25}},
+	}
+	require.NotDeepEqual(s, p1, p2)
+}